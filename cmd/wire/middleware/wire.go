@@ -0,0 +1,15 @@
+//go:build wireinject
+
+package middleware
+
+import (
+	"github.com/arifai/zenith/internal/middleware"
+	"github.com/google/wire"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+func ProvideMiddleware(db *gorm.DB, redis *redis.Client) *middleware.Middleware {
+	wire.Build(middleware.New)
+	return &middleware.Middleware{}
+}
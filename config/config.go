@@ -2,37 +2,68 @@ package config
 
 import (
 	"aidanwoods.dev/go-paseto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"github.com/Netflix/go-env"
 	"github.com/joho/godotenv"
 	"log"
+	"os"
 )
 
 type (
 	// Config contains configuration settings loaded from environment variables.
 	Config struct {
-		DatabaseHost     string `env:"DB_HOST"`
-		DatabasePort     string `env:"DB_PORT"`
-		DatabaseName     string `env:"DB_NAME"`
-		DatabaseUser     string `env:"DB_USER"`
-		DatabasePassword string `env:"DB_PASSWORD"`
-		SslMode          string `env:"SSL_MODE"`
-		Timezone         string `env:"TIMEZONE"`
-		PasswordSalt     string `env:"PASSWORD_SALT"`
-		SMTPHost         string `env:"SMTP_HOST"`
-		SMTPPort         int    `env:"SMTP_PORT"`
-		SMTPUsername     string `env:"SMTP_USERNAME"`
-		SMTPPassword     string `env:"SMTP_PASSWORD"`
-		RedisHost        string `env:"REDIS_HOST"`
-		RedisPort        int    `env:"REDIS_PORT"`
-		RedisDatabase    int    `env:"REDIS_DB"`
-		RedisUsername    string `env:"REDIS_USERNAME"`
-		RedisPassword    string `env:"REDIS_PASSWORD"`
+		DatabaseHost         string `env:"DB_HOST"`
+		DatabasePort         string `env:"DB_PORT"`
+		DatabaseName         string `env:"DB_NAME"`
+		DatabaseUser         string `env:"DB_USER"`
+		DatabasePassword     string `env:"DB_PASSWORD"`
+		SslMode              string `env:"SSL_MODE"`
+		Timezone             string `env:"TIMEZONE"`
+		PasswordSalt         string `env:"PASSWORD_SALT"`
+		SMTPHost             string `env:"SMTP_HOST"`
+		SMTPPort             int    `env:"SMTP_PORT"`
+		SMTPUsername         string `env:"SMTP_USERNAME"`
+		SMTPPassword         string `env:"SMTP_PASSWORD"`
+		RedisHost            string `env:"REDIS_HOST"`
+		RedisPort            int    `env:"REDIS_PORT"`
+		RedisDatabase        int    `env:"REDIS_DB"`
+		RedisUsername        string `env:"REDIS_USERNAME"`
+		RedisPassword        string `env:"REDIS_PASSWORD"`
+		OIDCIssuer           string `env:"OIDC_ISSUER"`
+		Argon2Time           uint32 `env:"ARGON2_TIME"`
+		Argon2Memory         uint32 `env:"ARGON2_MEMORY"`
+		Argon2Threads        uint8  `env:"ARGON2_THREADS"`
+		Argon2KeyLen         uint32 `env:"ARGON2_KEY_LEN"`
+		Argon2SaltLen        uint32 `env:"ARGON2_SALT_LEN"`
+		GoogleClientId       string `env:"GOOGLE_CLIENT_ID"`
+		GoogleClientSecret   string `env:"GOOGLE_CLIENT_SECRET"`
+		GitHubClientId       string `env:"GITHUB_CLIENT_ID"`
+		GitHubClientSecret   string `env:"GITHUB_CLIENT_SECRET"`
+		KeycloakIssuerURL    string `env:"KEYCLOAK_ISSUER_URL"`
+		KeycloakClientId     string `env:"KEYCLOAK_CLIENT_ID"`
+		KeycloakClientSecret string `env:"KEYCLOAK_CLIENT_SECRET"`
+		NotificationDriver   string `env:"NOTIFICATION_DRIVER"`
+		APNsTopic            string `env:"APNS_TOPIC"`
 	}
 )
 
 var (
-	SecretKey = paseto.NewV4AsymmetricSecretKey()
+	// SecretKey signs Zenith's PASETO access/refresh tokens. Loaded from
+	// PASETO_SECRET_KEY when set, so a process restart doesn't rotate the
+	// key out from under every token already handed out; generated fresh
+	// otherwise, which is only safe for local development.
+	SecretKey = loadOrGeneratePasetoKey()
 	PublicKey = SecretKey.Public()
+
+	// OIDCSigningKey is the RSA key used to sign id_tokens for OIDC clients
+	// that cannot verify Zenith's native Ed25519 PASETO keys. Loaded from
+	// OIDC_SIGNING_KEY when set, so the published jwks_uri and any
+	// previously-issued id_token stay verifiable across a restart;
+	// generated fresh otherwise, which is only safe for local development.
+	OIDCSigningKey = loadOrGenerateOIDCSigningKey()
 )
 
 // NewConfig creates and loads a new Config instance from the environment.
@@ -41,6 +72,53 @@ func NewConfig(filenames ...string) *Config {
 	return &config
 }
 
+// loadOrGeneratePasetoKey loads SecretKey from PASETO_SECRET_KEY (a hex-
+// encoded 64-byte Ed25519 secret key, not a 32-byte seed) when set. It loads .env itself, best-effort, since
+// this runs as a package-level initializer before NewConfig gets a chance
+// to.
+func loadOrGeneratePasetoKey() paseto.V4AsymmetricSecretKey {
+	_ = godotenv.Load()
+
+	if encoded := os.Getenv("PASETO_SECRET_KEY"); encoded != "" {
+		key, err := paseto.NewV4AsymmetricSecretKeyFromHex(encoded)
+		if err != nil {
+			log.Fatalf("Failed to parse PASETO_SECRET_KEY: %v", err)
+		}
+
+		return key
+	}
+
+	log.Println("PASETO_SECRET_KEY not set; generating an ephemeral key for this process only")
+	return paseto.NewV4AsymmetricSecretKey()
+}
+
+// loadOrGenerateOIDCSigningKey loads OIDCSigningKey from OIDC_SIGNING_KEY (a
+// PEM-encoded PKCS#1 RSA private key) when set, generating a fresh key pair
+// otherwise, which is only safe for local development.
+func loadOrGenerateOIDCSigningKey() *rsa.PrivateKey {
+	if encoded := os.Getenv("OIDC_SIGNING_KEY"); encoded != "" {
+		block, _ := pem.Decode([]byte(encoded))
+		if block == nil {
+			log.Fatalf("Failed to decode OIDC_SIGNING_KEY as PEM")
+		}
+
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			log.Fatalf("Failed to parse OIDC_SIGNING_KEY: %v", err)
+		}
+
+		return key
+	}
+
+	log.Println("OIDC_SIGNING_KEY not set; generating an ephemeral key for this process only")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("Failed to generate OIDC signing key: %v", err)
+	}
+
+	return key
+}
+
 // loadEnvFile loads the configuration from the provided `.env` files and environment variables.
 func loadEnvFile(filenames ...string) Config {
 	if err := godotenv.Load(filenames...); err != nil {
@@ -1,12 +1,12 @@
 package handler
 
 import (
-	"github.com/arifai/go-modular-monolithic/config"
-	"github.com/arifai/go-modular-monolithic/internal/account/api/types"
-	"github.com/arifai/go-modular-monolithic/internal/account/domain/service"
-	"github.com/arifai/go-modular-monolithic/pkg/common"
-	"github.com/arifai/go-modular-monolithic/pkg/core"
-	"github.com/arifai/go-modular-monolithic/pkg/utils"
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/internal/account/api/types"
+	"github.com/arifai/zenith/internal/account/domain/service"
+	"github.com/arifai/zenith/pkg/common"
+	"github.com/arifai/zenith/pkg/core"
+	"github.com/arifai/zenith/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"gorm.io/gorm"
 )
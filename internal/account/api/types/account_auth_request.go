@@ -0,0 +1,10 @@
+// Package types holds the request/response DTOs for the account API,
+// decoupled from internal/model so wire formats can evolve independently of
+// the persisted schema.
+package types
+
+// AccountAuthRequest is the body of POST /auth (local email/password login).
+type AccountAuthRequest struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required"`
+}
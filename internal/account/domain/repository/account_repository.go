@@ -0,0 +1,31 @@
+// Package repository provides data access for the account domain.
+package repository
+
+import (
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// AccountRepository loads accounts for the auth middleware and account services.
+type AccountRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+// NewAccountRepository builds an AccountRepository.
+func NewAccountRepository(db *gorm.DB, redis *redis.Client) *AccountRepository {
+	return &AccountRepository{db: db, redis: redis}
+}
+
+// Find returns the active account identified by id.
+func (r *AccountRepository) Find(id uuid.UUID) (*model.Account, error) {
+	var account model.Account
+	if err := r.db.First(&account, "id = ? AND is_active = ?", id, true).Error; err != nil {
+		return nil, errormessage.ErrCannotFindAuthorizedAccount
+	}
+
+	return &account, nil
+}
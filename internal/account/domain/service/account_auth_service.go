@@ -0,0 +1,105 @@
+// Package service implements the account domain's business logic: local
+// authentication and reading the authenticated account.
+package service
+
+import (
+	"time"
+
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/internal/account/api/types"
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/pkg/crypto"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// accessTokenTTL and refreshTokenTTL bound the lifetime of tokens issued by local login.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// AuthorizeResult is the token pair returned by a successful login.
+type AuthorizeResult struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+// AccountAuthService handles local email/password authentication.
+type AccountAuthService struct {
+	db     *gorm.DB
+	config *config.Config
+	hasher crypto.PasswordHasher
+}
+
+// NewAccountAuthService builds an AccountAuthService, configuring its
+// PasswordHasher from config's Argon2 cost parameters when they're set, and
+// falling back to crypto.DefaultArgon2IDHash otherwise. It can additionally
+// verify bcrypt/scrypt hashes left over from before Zenith standardized on
+// Argon2ID, rehashing them to Argon2ID on next successful login.
+func NewAccountAuthService(db *gorm.DB, config *config.Config) *AccountAuthService {
+	return &AccountAuthService{db: db, config: config, hasher: buildHasher(config)}
+}
+
+// buildHasher constructs the MultiHasher NewAccountAuthService verifies
+// passwords with, tuning Argon2ID's cost parameters from config when an
+// operator has set them.
+func buildHasher(cfg *config.Config) crypto.PasswordHasher {
+	argon2Hash := crypto.DefaultArgon2IDHash
+	if cfg.Argon2Time > 0 && cfg.Argon2Memory > 0 && cfg.Argon2Threads > 0 && cfg.Argon2KeyLen > 0 && cfg.Argon2SaltLen > 0 {
+		argon2Hash = crypto.NewArgon2IdHash(cfg.Argon2Time, cfg.Argon2Memory, cfg.Argon2Threads, cfg.Argon2KeyLen, cfg.Argon2SaltLen)
+	}
+
+	return crypto.NewMultiHasher(argon2Hash, crypto.DefaultBcryptHash, crypto.DefaultScryptHash)
+}
+
+// Authorize verifies req against the matching active account and, on
+// success, issues a fresh token pair. A password verified against a weaker
+// algorithm or cost than the configured default is transparently rehashed.
+func (s *AccountAuthService) Authorize(req *types.AccountAuthRequest) (*AuthorizeResult, error) {
+	var account model.Account
+	if err := s.db.First(&account, "email = ? AND is_active = ?", req.Email, true).Error; err != nil {
+		return nil, errormessage.ErrInvalidCredentials
+	}
+
+	ok, needsRehash, err := s.hasher.Verify([]byte(req.Password), account.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errormessage.ErrInvalidCredentials
+	}
+
+	if needsRehash {
+		if rehashed, err := s.hasher.Hash([]byte(req.Password)); err == nil {
+			s.db.Model(&account).Update("password", rehashed)
+		}
+	}
+
+	return s.IssueTokens(account.ID)
+}
+
+// IssueTokens mints a fresh access/refresh token pair for accountId, with no
+// password check - used after an account has already been authenticated by
+// some other means, e.g. a federated login.
+func (s *AccountAuthService) IssueTokens(accountId uuid.UUID) (*AuthorizeResult, error) {
+	accessToken, err := crypto.GenerateToken(accountId, "access_token", accessTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := crypto.GenerateToken(accountId, "refresh_token", refreshTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthorizeResult{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(accessTokenTTL.Seconds()),
+	}, nil
+}
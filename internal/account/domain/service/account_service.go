@@ -0,0 +1,37 @@
+package service
+
+import (
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/pkg/core"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AccountResult is the profile returned for the currently authenticated account.
+type AccountResult struct {
+	Id    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+	Name  string    `json:"name"`
+}
+
+// AccountService reads data about the authenticated account.
+type AccountService struct {
+	db     *gorm.DB
+	config *config.Config
+}
+
+// NewAccountService builds an AccountService.
+func NewAccountService(db *gorm.DB, config *config.Config) *AccountService {
+	return &AccountService{db: db, config: config}
+}
+
+// GetAccount returns the profile of the account ctx's Auth middleware
+// authenticated the request as.
+func (s *AccountService) GetAccount(ctx *core.Context) (*AccountResult, error) {
+	if ctx.Account == nil {
+		return nil, errormessage.ErrCannotFindAuthorizedAccount
+	}
+
+	return &AccountResult{Id: ctx.Account.ID, Email: ctx.Account.Email, Name: ctx.Account.Name}, nil
+}
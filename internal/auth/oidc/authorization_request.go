@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// authorizationRequestPrefix namespaces pending authorization requests in Redis.
+const authorizationRequestPrefix = "oidc:authorization_request:"
+
+// authorizationRequestTTL bounds how long an authorization_code (and the
+// request that produced it) remains redeemable.
+const authorizationRequestTTL = 10 * time.Minute
+
+// AuthorizationRequest is the short-lived state created by `/authorize` and
+// consumed by `/token` when exchanging an authorization_code.
+type AuthorizationRequest struct {
+	ClientId            string    `json:"client_id"`
+	RedirectURI         string    `json:"redirect_uri"`
+	Scope               string    `json:"scope"`
+	State               string    `json:"state"`
+	CodeChallenge       string    `json:"code_challenge"`
+	CodeChallengeMethod string    `json:"code_challenge_method"`
+	AccountId           string    `json:"account_id"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// authorizationRequestStore persists AuthorizationRequest values in Redis,
+// keyed by the authorization_code handed to the client.
+type authorizationRequestStore struct {
+	redis *redis.Client
+}
+
+// newAuthorizationRequestStore returns a store backed by the given Redis client.
+func newAuthorizationRequestStore(redis *redis.Client) *authorizationRequestStore {
+	return &authorizationRequestStore{redis: redis}
+}
+
+// Save stores req under code with the authorization request TTL.
+func (s *authorizationRequestStore) Save(ctx context.Context, code string, req *AuthorizationRequest) error {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal authorization request: %w", err)
+	}
+
+	return s.redis.Set(ctx, authorizationRequestPrefix+code, payload, authorizationRequestTTL).Err()
+}
+
+// Consume retrieves and atomically deletes the request stored under code,
+// preventing an authorization_code from being redeemed twice.
+func (s *authorizationRequestStore) Consume(ctx context.Context, code string) (*AuthorizationRequest, error) {
+	key := authorizationRequestPrefix + code
+
+	payload, err := s.redis.GetDel(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, errInvalidGrant
+		}
+		return nil, fmt.Errorf("failed to load authorization request: %w", err)
+	}
+
+	var req AuthorizationRequest
+	if err := json.Unmarshal([]byte(payload), &req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal authorization request: %w", err)
+	}
+
+	return &req, nil
+}
@@ -0,0 +1,11 @@
+package oidc
+
+import "errors"
+
+var (
+	errInvalidClient       = errors.New("oidc: invalid client credentials")
+	errInvalidGrant        = errors.New("oidc: invalid or expired grant")
+	errInvalidRequest      = errors.New("oidc: invalid request")
+	errUnsupportedGrant    = errors.New("oidc: unsupported grant_type")
+	errInvalidCodeVerifier = errors.New("oidc: code_verifier does not match code_challenge")
+)
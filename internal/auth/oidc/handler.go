@@ -0,0 +1,144 @@
+package oidc
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/pkg/common"
+	"github.com/arifai/zenith/pkg/core"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// DiscoveryHandler serves /.well-known/openid-configuration.
+func DiscoveryHandler(ctx *gin.Context, config *config.Config) {
+	ctx.JSON(http.StatusOK, buildDiscoveryDocument(config.OIDCIssuer))
+}
+
+// JwksHandler serves /.well-known/jwks.json.
+func JwksHandler(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, buildJWKSet())
+}
+
+// AuthorizeHandler handles /authorize for an already-authenticated account,
+// issuing an authorization_code and redirecting back to the client.
+func AuthorizeHandler(ctx *gin.Context, db *gorm.DB, redisClient *redis.Client, config *config.Config) {
+	resp := new(common.Response)
+	context := core.NewContext(ctx)
+
+	req := &AuthorizationRequest{
+		ClientId:            ctx.Query("client_id"),
+		RedirectURI:         ctx.Query("redirect_uri"),
+		Scope:               ctx.Query("scope"),
+		State:               ctx.Query("state"),
+		CodeChallenge:       ctx.Query("code_challenge"),
+		CodeChallengeMethod: ctx.Query("code_challenge_method"),
+		AccountId:           context.Account.ID.String(),
+	}
+
+	service := NewService(db, redisClient, config)
+	code, err := service.Authorize(ctx, req)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	redirectURI := req.RedirectURI + "?code=" + code
+	if req.State != "" {
+		redirectURI += "&state=" + req.State
+	}
+
+	ctx.Redirect(http.StatusFound, redirectURI)
+}
+
+// TokenHandler handles /token for all supported grant types.
+func TokenHandler(ctx *gin.Context, db *gorm.DB, redisClient *redis.Client, config *config.Config) {
+	resp := new(common.Response)
+
+	if err := ctx.Request.ParseForm(); err != nil {
+		resp.Error(ctx, errInvalidRequest)
+		return
+	}
+
+	params := make(map[string]string, len(ctx.Request.PostForm))
+	for key := range ctx.Request.PostForm {
+		params[key] = ctx.Request.PostForm.Get(key)
+	}
+
+	// RFC 6749 §2.3.1: client_secret_basic clients send credentials via the
+	// Authorization header instead of the body; prefer those when present.
+	if clientId, clientSecret, ok := ctx.Request.BasicAuth(); ok {
+		params["client_id"] = clientId
+		params["client_secret"] = clientSecret
+	}
+
+	service := NewService(db, redisClient, config)
+	token, err := service.Token(ctx, params["grant_type"], params)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	resp.Success(ctx, token)
+}
+
+// UserInfoHandler handles /userinfo, returning standard claims for the
+// account identified by the bearer access token.
+func UserInfoHandler(ctx *gin.Context, db *gorm.DB, redisClient *redis.Client, config *config.Config) {
+	resp := new(common.Response)
+
+	accessToken, err := bearerToken(ctx)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	service := NewService(db, redisClient, config)
+	claims, err := service.UserInfo(ctx, accessToken)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	resp.Success(ctx, claims)
+}
+
+// RevokeHandler handles /revoke, per RFC 7009.
+func RevokeHandler(ctx *gin.Context, db *gorm.DB, redisClient *redis.Client, config *config.Config) {
+	resp := new(common.Response)
+
+	service := NewService(db, redisClient, config)
+	if err := service.Revoke(ctx.PostForm("token")); err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// IntrospectHandler handles /introspect, per RFC 7662.
+func IntrospectHandler(ctx *gin.Context, db *gorm.DB, redisClient *redis.Client, config *config.Config) {
+	resp := new(common.Response)
+
+	service := NewService(db, redisClient, config)
+	result, err := service.Introspect(ctx.PostForm("token"))
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	resp.Success(ctx, result)
+}
+
+// bearerToken extracts the bearer token from the Authorization header.
+func bearerToken(ctx *gin.Context) (string, error) {
+	authHeader := ctx.GetHeader("Authorization")
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return "", errInvalidRequest
+	}
+
+	return parts[1], nil
+}
@@ -0,0 +1,72 @@
+package oidc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/arifai/zenith/config"
+)
+
+// jwk is a single JSON Web Key as served from /.well-known/jwks.json.
+type jwk struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// jwkSet is the document served at /.well-known/jwks.json.
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+const (
+	edSigningKeyId  = "zenith-eddsa-1"
+	rsaSigningKeyId = "zenith-rs256-1"
+)
+
+// buildJWKSet derives the JWK set from Zenith's PASETO Ed25519 public key and
+// the RSA key used for standard OIDC clients.
+func buildJWKSet() jwkSet {
+	publicKeyBytes := config.PublicKey.ExportBytes()
+
+	rsaPublicKey := config.OIDCSigningKey.PublicKey
+
+	return jwkSet{
+		Keys: []jwk{
+			{
+				Kty: "OKP",
+				Use: "sig",
+				Kid: edSigningKeyId,
+				Alg: "EdDSA",
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(publicKeyBytes),
+			},
+			{
+				Kty: "RSA",
+				Use: "sig",
+				Kid: rsaSigningKeyId,
+				Alg: "RS256",
+				N:   base64.RawURLEncoding.EncodeToString(rsaPublicKey.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(encodeRSAExponent(rsaPublicKey.E)),
+			},
+		},
+	}
+}
+
+// encodeRSAExponent encodes an RSA public exponent as a minimal big-endian byte slice.
+func encodeRSAExponent(e int) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(e))
+
+	i := 0
+	for i < len(buf)-1 && buf[i] == 0 {
+		i++
+	}
+
+	return buf[i:]
+}
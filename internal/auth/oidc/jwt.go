@@ -0,0 +1,55 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/arifai/zenith/config"
+)
+
+// jwtHeader is the JOSE header for the id_token, naming the JWKS key (kid)
+// that verifies it.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid"`
+}
+
+// idTokenClaims are the standard OIDC claims carried by the id_token.
+type idTokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// signIdToken encodes claims as a standard JWT (base64url header, payload,
+// and signature, dot-separated) and signs it RS256 with the RSA key
+// published at jwks_uri under rsaSigningKeyId, so that any OIDC client can
+// verify it without having to understand Zenith's native PASETO tokens.
+func signIdToken(claims idTokenClaims) (string, error) {
+	headerJSON, err := json.Marshal(jwtHeader{Alg: "RS256", Typ: "JWT", Kid: rsaSigningKeyId})
+	if err != nil {
+		return "", err
+	}
+
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, config.OIDCSigningKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,10 @@
+package oidc
+
+// Models returns the GORM models owned by the oidc package, for registration
+// with internal/model/migration's AutoMigrate list (wired through ProvideMigration).
+func Models() []interface{} {
+	return []interface{}{
+		&OAuthClient{},
+		&RefreshToken{},
+	}
+}
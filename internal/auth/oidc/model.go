@@ -0,0 +1,49 @@
+package oidc
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// OAuthClient is a registered OIDC/OAuth2 client allowed to use the
+// authorization server.
+type OAuthClient struct {
+	ID           uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	ClientId     string         `gorm:"uniqueIndex;not null"`
+	SecretHash   string         `gorm:"not null"`
+	Name         string         `gorm:"not null"`
+	RedirectURIs pq.StringArray `gorm:"type:text[]"`
+	GrantTypes   pq.StringArray `gorm:"type:text[]"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// TableName overrides the default pluralized table name.
+func (OAuthClient) TableName() string {
+	return "oauth_clients"
+}
+
+// RefreshToken is a long-lived, rotatable token issued to an OAuthClient on
+// behalf of an account.
+type RefreshToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	AccountId uuid.UUID `gorm:"type:uuid;not null;index"`
+	ClientId  uuid.UUID `gorm:"type:uuid;not null;index"`
+	Scope     string
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// TableName overrides the default pluralized table name.
+func (RefreshToken) TableName() string {
+	return "oauth_refresh_tokens"
+}
+
+// IsValid reports whether the refresh token can still be exchanged.
+func (r *RefreshToken) IsValid() bool {
+	return r.RevokedAt == nil && time.Now().Before(r.ExpiresAt)
+}
@@ -0,0 +1,316 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// accessTokenTTL and refreshTokenTTL bound the lifetime of tokens issued by
+// the authorization server.
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// Service implements the OIDC authorization server: authorization_code +
+// PKCE, client_credentials, and refresh_token grants, plus userinfo,
+// revocation, and introspection.
+type Service struct {
+	db      *gorm.DB
+	redis   *redis.Client
+	config  *config.Config
+	authReq *authorizationRequestStore
+}
+
+// NewService creates a new OIDC Service.
+func NewService(db *gorm.DB, redis *redis.Client, config *config.Config) *Service {
+	return &Service{
+		db:      db,
+		redis:   redis,
+		config:  config,
+		authReq: newAuthorizationRequestStore(redis),
+	}
+}
+
+// TokenResponse is the standard OAuth2/OIDC response returned by the token endpoint.
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	IdToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// Authorize validates an authorization request from an authenticated account
+// and returns the authorization_code to redirect back to the client with.
+func (s *Service) Authorize(ctx context.Context, req *AuthorizationRequest) (code string, err error) {
+	var client OAuthClient
+	if err := s.db.First(&client, "client_id = ?", req.ClientId).Error; err != nil {
+		return "", errInvalidClient
+	}
+
+	if !containsString(client.RedirectURIs, req.RedirectURI) {
+		return "", errInvalidRequest
+	}
+
+	code = uuid.NewString()
+	req.CreatedAt = time.Now()
+	if err := s.authReq.Save(ctx, code, req); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// Token dispatches a /token request to the handler for the requested grant_type.
+func (s *Service) Token(ctx context.Context, grantType string, params map[string]string) (*TokenResponse, error) {
+	switch grantType {
+	case "authorization_code":
+		return s.exchangeAuthorizationCode(ctx, params)
+	case "client_credentials":
+		return s.exchangeClientCredentials(ctx, params)
+	case "refresh_token":
+		return s.exchangeRefreshToken(ctx, params)
+	default:
+		return nil, errUnsupportedGrant
+	}
+}
+
+// exchangeAuthorizationCode redeems a PKCE-protected authorization_code for tokens.
+func (s *Service) exchangeAuthorizationCode(ctx context.Context, params map[string]string) (*TokenResponse, error) {
+	req, err := s.authReq.Consume(ctx, params["code"])
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ClientId != params["client_id"] || req.RedirectURI != params["redirect_uri"] {
+		return nil, errInvalidGrant
+	}
+
+	if !verifyCodeChallenge(req.CodeChallenge, req.CodeChallengeMethod, params["code_verifier"]) {
+		return nil, errInvalidCodeVerifier
+	}
+
+	client, err := s.authenticateClient(req.ClientId, params["client_secret"])
+	if err != nil {
+		return nil, err
+	}
+
+	accountId, err := uuid.Parse(req.AccountId)
+	if err != nil {
+		return nil, errInvalidGrant
+	}
+
+	return s.issueTokens(accountId, client, req.Scope, true)
+}
+
+// exchangeClientCredentials issues a token scoped to the client itself, with no account subject.
+func (s *Service) exchangeClientCredentials(_ context.Context, params map[string]string) (*TokenResponse, error) {
+	client, err := s.authenticateClient(params["client_id"], params["client_secret"])
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(uuid.Nil, client, params["scope"], false)
+}
+
+// exchangeRefreshToken rotates a refresh token for a new access/refresh token pair.
+func (s *Service) exchangeRefreshToken(_ context.Context, params map[string]string) (*TokenResponse, error) {
+	client, err := s.authenticateClient(params["client_id"], params["client_secret"])
+	if err != nil {
+		return nil, err
+	}
+
+	var stored RefreshToken
+	tokenHash := hashToken(params["refresh_token"])
+	if err := s.db.First(&stored, "token_hash = ? AND client_id = ?", tokenHash, client.ID).Error; err != nil {
+		return nil, errInvalidGrant
+	}
+
+	if !stored.IsValid() {
+		return nil, errInvalidGrant
+	}
+
+	s.db.Model(&stored).Update("revoked_at", time.Now())
+
+	return s.issueTokens(stored.AccountId, client, stored.Scope, stored.AccountId != uuid.Nil)
+}
+
+// issueTokens mints an access token (as a PASETO token, matching the rest of
+// Zenith's auth) plus, when withRefresh is set, a persisted refresh token.
+func (s *Service) issueTokens(accountId uuid.UUID, client *OAuthClient, scope string, withRefresh bool) (*TokenResponse, error) {
+	now := time.Now()
+
+	token := paseto.NewToken()
+	token.SetIssuedAt(now)
+	token.SetExpiration(now.Add(accessTokenTTL))
+	token.SetString("account_id", accountId.String())
+	token.SetString("client_id", client.ClientId)
+	token.SetString("scope", scope)
+	token.SetString("jti", uuid.NewString())
+
+	accessToken := token.V4Sign(config.SecretKey, nil)
+
+	resp := &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(accessTokenTTL.Seconds()),
+		Scope:       scope,
+	}
+
+	if withRefresh {
+		refreshToken := uuid.NewString()
+		record := RefreshToken{
+			TokenHash: hashToken(refreshToken),
+			AccountId: accountId,
+			ClientId:  client.ID,
+			Scope:     scope,
+			ExpiresAt: now.Add(refreshTokenTTL),
+		}
+		if err := s.db.Create(&record).Error; err != nil {
+			return nil, err
+		}
+		resp.RefreshToken = refreshToken
+	}
+
+	if containsScope(scope, "openid") && accountId != uuid.Nil {
+		idToken, err := s.issueIdToken(accountId, client.ClientId, now)
+		if err != nil {
+			return nil, err
+		}
+		resp.IdToken = idToken
+	}
+
+	return resp, nil
+}
+
+// issueIdToken builds a JWT id_token for an authenticated account, signed so
+// that standard OIDC clients can verify it against the published JWKS.
+func (s *Service) issueIdToken(accountId uuid.UUID, clientId string, now time.Time) (string, error) {
+	return signIdToken(idTokenClaims{
+		Issuer:    s.config.OIDCIssuer,
+		Subject:   accountId.String(),
+		Audience:  clientId,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(accessTokenTTL).Unix(),
+	})
+}
+
+// UserInfo returns the OIDC standard claims for the account identified by accessToken.
+func (s *Service) UserInfo(ctx context.Context, accessToken string) (map[string]interface{}, error) {
+	token, err := paseto.NewParser().ParseV4Public(config.PublicKey, accessToken, nil)
+	if err != nil {
+		return nil, errInvalidGrant
+	}
+
+	accountIdStr, err := token.GetString("account_id")
+	if err != nil {
+		return nil, errInvalidGrant
+	}
+
+	accountId, err := uuid.Parse(accountIdStr)
+	if err != nil {
+		return nil, errInvalidGrant
+	}
+
+	var account model.Account
+	if err := s.db.WithContext(ctx).First(&account, "id = ?", accountId).Error; err != nil {
+		return nil, errInvalidGrant
+	}
+
+	return map[string]interface{}{
+		"sub":   account.ID.String(),
+		"email": account.Email,
+		"name":  account.Name,
+	}, nil
+}
+
+// Revoke revokes a refresh token, making it unusable for future token exchanges.
+func (s *Service) Revoke(token string) error {
+	return s.db.Model(&RefreshToken{}).Where("token_hash = ?", hashToken(token)).Update("revoked_at", time.Now()).Error
+}
+
+// Introspect reports whether a refresh token is currently active, per RFC 7662.
+func (s *Service) Introspect(token string) (map[string]interface{}, error) {
+	var stored RefreshToken
+	if err := s.db.First(&stored, "token_hash = ?", hashToken(token)).Error; err != nil {
+		return map[string]interface{}{"active": false}, nil
+	}
+
+	return map[string]interface{}{
+		"active":    stored.IsValid(),
+		"scope":     stored.Scope,
+		"exp":       stored.ExpiresAt.Unix(),
+		"client_id": stored.ClientId.String(),
+	}, nil
+}
+
+// authenticateClient loads an OAuthClient and verifies its secret.
+func (s *Service) authenticateClient(clientId, secret string) (*OAuthClient, error) {
+	var client OAuthClient
+	if err := s.db.First(&client, "client_id = ?", clientId).Error; err != nil {
+		return nil, errInvalidClient
+	}
+
+	ok, err := crypto.VerifyHash(secret, client.SecretHash)
+	if err != nil || !ok {
+		return nil, errInvalidClient
+	}
+
+	return &client, nil
+}
+
+// hashToken hashes an opaque token for storage, so the database never holds
+// a usable bearer value at rest.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyCodeChallenge validates a PKCE code_verifier against the
+// code_challenge stored with the authorization request. Only S256 is supported.
+func verifyCodeChallenge(challenge, method, verifier string) bool {
+	if method != "S256" {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
+}
+
+// containsString reports whether list contains value.
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// splitScope splits a space-delimited OAuth2/OIDC scope string into its
+// members, per RFC 6749 section 3.3.
+func splitScope(scope string) []string {
+	return strings.Fields(scope)
+}
+
+// containsScope reports whether value is one of scope's space-delimited members.
+func containsScope(scope, value string) bool {
+	return containsString(splitScope(scope), value)
+}
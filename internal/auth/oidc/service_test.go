@@ -0,0 +1,41 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+)
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	verifier := "a-random-code-verifier-value"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	if !verifyCodeChallenge(challenge, "S256", verifier) {
+		t.Fatal("expected matching code_verifier to verify")
+	}
+
+	if verifyCodeChallenge(challenge, "S256", "wrong-verifier") {
+		t.Fatal("expected mismatched code_verifier to fail verification")
+	}
+
+	if verifyCodeChallenge(challenge, "plain", verifier) {
+		t.Fatal("expected unsupported code_challenge_method to fail verification")
+	}
+}
+
+func TestContainsScope(t *testing.T) {
+	scope := "openid profile email"
+
+	if !containsScope(scope, "openid") {
+		t.Fatal("expected \"openid\" to be found among multiple requested scopes")
+	}
+
+	if containsScope(scope, "admin") {
+		t.Fatal("expected \"admin\" not to be found in scope")
+	}
+
+	if containsScope("", "openid") {
+		t.Fatal("expected empty scope to contain nothing")
+	}
+}
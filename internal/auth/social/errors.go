@@ -0,0 +1,8 @@
+package social
+
+import "errors"
+
+var (
+	errUnknownProvider = errors.New("social: unknown provider")
+	errInvalidState    = errors.New("social: invalid or missing oauth state")
+)
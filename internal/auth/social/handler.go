@@ -0,0 +1,141 @@
+package social
+
+import (
+	"net/http"
+
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/internal/account/domain/service"
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/pkg/common"
+	"github.com/arifai/zenith/pkg/core"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NewDefaultRegistry builds the Registry of federated providers enabled via config.
+func NewDefaultRegistry(config *config.Config, baseURL string) *Registry {
+	return NewRegistry(
+		NewGoogleProvider(config.GoogleClientId, config.GoogleClientSecret, baseURL+"/auth/google/callback"),
+		NewGitHubProvider(config.GitHubClientId, config.GitHubClientSecret, baseURL+"/auth/github/callback"),
+		NewKeycloakProvider(config.KeycloakIssuerURL, config.KeycloakClientId, config.KeycloakClientSecret, baseURL+"/auth/keycloak/callback"),
+	)
+}
+
+// LoginHandler handles `/auth/{provider}/login` by redirecting to the provider's consent screen.
+func LoginHandler(ctx *gin.Context, registry *Registry) {
+	resp := new(common.Response)
+
+	provider, ok := registry.Get(ctx.Param("provider"))
+	if !ok {
+		resp.Error(ctx, errUnknownProvider)
+		return
+	}
+
+	state := uuid.NewString()
+	ctx.SetCookie("zenith_oauth_state", state, 600, "/", "", true, true)
+	ctx.Redirect(http.StatusFound, provider.AuthCodeURL(state))
+}
+
+// CallbackHandler handles `/auth/{provider}/callback`. It either signs in an
+// existing linked account, links the external identity to the currently
+// authenticated account, or creates a new account, then issues Zenith's
+// PASETO tokens through the same response path as the local AuthHandler.
+func CallbackHandler(ctx *gin.Context, db *gorm.DB, config *config.Config, registry *Registry) {
+	resp := new(common.Response)
+
+	provider, ok := registry.Get(ctx.Param("provider"))
+	if !ok {
+		resp.Error(ctx, errUnknownProvider)
+		return
+	}
+
+	expectedState, err := ctx.Cookie("zenith_oauth_state")
+	if err != nil || expectedState != ctx.Query("state") {
+		resp.Error(ctx, errInvalidState)
+		return
+	}
+
+	identity, err := provider.Exchange(ctx, ctx.Query("code"))
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	currentAccount := core.NewContext(ctx).Account
+
+	accountId, err := findOrCreateAccount(db, identity, currentAccount)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	accountService := service.NewAccountAuthService(db, config)
+	result, err := accountService.IssueTokens(accountId)
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	resp.Authorized(ctx, result)
+}
+
+// findOrCreateAccount resolves identity to an account id, linking or
+// creating an account as needed:
+//
+//  1. If identity is already linked, sign in as the account it's linked to.
+//  2. Otherwise, if currentAccount is set (the request carried a valid
+//     session), link identity to that account rather than matching or
+//     creating by email.
+//  3. Otherwise, auto-match an existing account by identity.Email only if
+//     the provider itself verified that email - an unverified email is
+//     claimed, not proven, and federated providers like GitHub return one
+//     without proving it, so trusting it here would let anyone who can get
+//     a provider to hand back a victim's email silently authenticate as
+//     that victim's existing account.
+//  4. Otherwise, create a new account.
+func findOrCreateAccount(db *gorm.DB, identity *ExternalIdentity, currentAccount *model.Account) (uuid.UUID, error) {
+	var linked LinkedIdentity
+	if err := db.First(&linked, "provider = ? AND subject = ?", identity.Provider, identity.Subject).Error; err == nil {
+		return linked.AccountId, nil
+	}
+
+	accountId, err := resolveAccountId(db, identity, currentAccount)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := db.Create(&LinkedIdentity{Provider: identity.Provider, Subject: identity.Subject, AccountId: accountId}).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	return accountId, nil
+}
+
+// resolveAccountId implements steps 2-4 of findOrCreateAccount's resolution order.
+func resolveAccountId(db *gorm.DB, identity *ExternalIdentity, currentAccount *model.Account) (uuid.UUID, error) {
+	if currentAccount != nil {
+		return currentAccount.ID, nil
+	}
+
+	if shouldAutoLinkByEmail(identity) {
+		var account model.Account
+		if err := db.First(&account, "email = ?", identity.Email).Error; err == nil {
+			return account.ID, nil
+		}
+	}
+
+	account := model.Account{Email: identity.Email, Name: identity.Name}
+	if err := db.Create(&account).Error; err != nil {
+		return uuid.Nil, err
+	}
+
+	return account.ID, nil
+}
+
+// shouldAutoLinkByEmail reports whether identity is eligible to be matched
+// against an existing account purely by email: only when the provider
+// itself verified it, since an unverified email is claimed, not proven.
+func shouldAutoLinkByEmail(identity *ExternalIdentity) bool {
+	return identity.EmailVerified && identity.Email != ""
+}
@@ -0,0 +1,30 @@
+package social
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LinkedIdentity links an external provider identity to a Zenith account.
+type LinkedIdentity struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Provider  string    `gorm:"not null;uniqueIndex:idx_provider_subject"`
+	Subject   string    `gorm:"not null;uniqueIndex:idx_provider_subject"`
+	AccountId uuid.UUID `gorm:"type:uuid;not null;index"`
+	CreatedAt time.Time
+}
+
+// TableName overrides the default pluralized table name.
+func (LinkedIdentity) TableName() string {
+	return "linked_identities"
+}
+
+// Models returns the GORM models owned by the social package, for
+// registration with internal/model/migration's AutoMigrate list (wired
+// through ProvideMigration).
+func Models() []interface{} {
+	return []interface{}{
+		&LinkedIdentity{},
+	}
+}
@@ -0,0 +1,54 @@
+package social
+
+import "context"
+
+// ExternalIdentity is the identity information returned by a federated
+// provider after a successful code exchange.
+type ExternalIdentity struct {
+	Provider string
+	Subject  string
+	Email    string
+	Name     string
+
+	// EmailVerified reports whether the provider itself confirmed ownership
+	// of Email. An unverified email must never be used to auto-match an
+	// existing Zenith account, since that would let anyone who can get a
+	// provider to hand back a victim's unverified email silently take over
+	// that victim's account.
+	EmailVerified bool
+}
+
+// Provider is a federated OAuth2/OIDC identity provider pluggable into the
+// existing local AuthHandler flow.
+type Provider interface {
+	// Name identifies the provider, e.g. "google", "github", "keycloak".
+	Name() string
+
+	// AuthCodeURL builds the URL to redirect the browser to for login,
+	// embedding state for CSRF protection.
+	AuthCodeURL(state string) string
+
+	// Exchange trades an authorization code for the caller's external identity.
+	Exchange(ctx context.Context, code string) (*ExternalIdentity, error)
+}
+
+// Registry looks providers up by name for the `/auth/{provider}/...` routes.
+type Registry struct {
+	providers map[string]Provider
+}
+
+// NewRegistry builds a Registry from the given providers.
+func NewRegistry(providers ...Provider) *Registry {
+	registry := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, provider := range providers {
+		registry.providers[provider.Name()] = provider
+	}
+
+	return registry
+}
+
+// Get returns the provider registered under name, or false if none is registered.
+func (r *Registry) Get(name string) (Provider, bool) {
+	provider, ok := r.providers[name]
+	return provider, ok
+}
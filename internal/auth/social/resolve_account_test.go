@@ -0,0 +1,25 @@
+package social
+
+import "testing"
+
+// TestShouldAutoLinkByEmail checks that only a non-empty, provider-verified
+// email is eligible for auto-matching an existing account.
+func TestShouldAutoLinkByEmail(t *testing.T) {
+	cases := []struct {
+		name     string
+		identity *ExternalIdentity
+		want     bool
+	}{
+		{"verified email may auto-link", &ExternalIdentity{Email: "a@example.com", EmailVerified: true}, true},
+		{"unverified email must not auto-link", &ExternalIdentity{Email: "a@example.com", EmailVerified: false}, false},
+		{"empty email must not auto-link", &ExternalIdentity{Email: "", EmailVerified: true}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := shouldAutoLinkByEmail(c.identity); got != c.want {
+				t.Fatalf("shouldAutoLinkByEmail(%+v) = %v, want %v", c.identity, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"context"
+	"time"
+)
+
+// TokenBlacklistPrefix namespaces blacklisted JTIs in Redis.
+const TokenBlacklistPrefix = "token:blacklist:"
+
+// BlacklistToken marks jti as revoked until ttl elapses, matching the
+// token's remaining lifetime so the key never outlives what it blacklists.
+func (m *Middleware) BlacklistToken(ctx context.Context, jti string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	return m.redis.Set(ctx, TokenBlacklistPrefix+jti, "1", ttl).Err()
+}
+
+// IsTokenBlacklisted reports whether jti has been revoked.
+func (m *Middleware) IsTokenBlacklisted(ctx context.Context, jti string) (bool, error) {
+	n, err := m.redis.Exists(ctx, TokenBlacklistPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
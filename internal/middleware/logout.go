@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/pkg/common"
+	crp "github.com/arifai/zenith/pkg/crypto"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/gin-gonic/gin"
+)
+
+// Logout handles `/auth/logout` by pushing the caller's current access
+// token's JTI into the blacklist Auth checks, so it can no longer be used
+// even though it hasn't expired yet.
+func (m *Middleware) Logout(ctx *gin.Context) {
+	resp := common.Response{}
+
+	tokenString, err := extractToken(ctx.GetHeader("Authorization"))
+	if err != nil {
+		resp.Unauthorized(ctx, nil, err.Error())
+		ctx.Abort()
+		return
+	}
+
+	tokenPayload, err := crp.VerifyToken(tokenString, config.PublicKey)
+	if err != nil {
+		resp.Unauthorized(ctx, nil, errormessage.ErrInvalidAccessToken.Error())
+		ctx.Abort()
+		return
+	}
+
+	ttl := time.Until(tokenPayload.Exp)
+	if err := m.BlacklistToken(ctx, tokenPayload.Jti.String(), ttl); err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	resp.Success(ctx, nil)
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/pkg/common"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// rateLimitScript atomically increments the request counter for a window and,
+// on the first request of the window, sets its expiry - avoiding the race
+// between a separate INCR and PEXPIRE.
+var rateLimitScript = redis.NewScript(`
+local current = redis.call("INCR", KEYS[1])
+if tonumber(current) == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return current
+`)
+
+// RateLimitKeyFunc derives the bucket key for a request, e.g. by IP, account id, or route.
+type RateLimitKeyFunc func(ctx *gin.Context) string
+
+// RateLimitOptions configures RateLimit per route group.
+type RateLimitOptions struct {
+	// Limit is the maximum number of requests allowed per Window.
+	Limit int
+
+	// Window is the fixed window the Limit applies to.
+	Window time.Duration
+
+	// KeyFunc derives the rate-limit bucket key. Defaults to the client IP.
+	KeyFunc RateLimitKeyFunc
+}
+
+// ByClientIP buckets requests by client IP address.
+func ByClientIP(ctx *gin.Context) string {
+	return ctx.ClientIP()
+}
+
+// ByAccount buckets requests by the authenticated account id set by Auth.
+func ByAccount(ctx *gin.Context) string {
+	if account, ok := ctx.Get("account"); ok {
+		if a, ok := account.(*model.Account); ok {
+			return a.ID.String()
+		}
+	}
+
+	return ByClientIP(ctx)
+}
+
+// RateLimit implements a Redis-backed fixed-window rate limiter keyed by
+// opts.KeyFunc. This is a deliberate simplification over a sliding-window or
+// token-bucket limiter: it's a single INCR+PEXPIRE per request, with no
+// extra Redis structures or background sweeping, at the cost of allowing up
+// to 2x opts.Limit in a burst that straddles a window boundary. Acceptable
+// for the abuse-prevention use cases this middleware targets today; revisit
+// if a route needs a hard per-second ceiling.
+func (m *Middleware) RateLimit(opts RateLimitOptions) gin.HandlerFunc {
+	keyFunc := opts.KeyFunc
+	if keyFunc == nil {
+		keyFunc = ByClientIP
+	}
+
+	return func(ctx *gin.Context) {
+		resp := common.Response{}
+		key := "rate_limit:" + ctx.FullPath() + ":" + keyFunc(ctx)
+
+		current, err := rateLimitScript.Run(ctx, m.redis, []string{key}, opts.Window.Milliseconds()).Int()
+		if err != nil {
+			resp.Error(ctx, err)
+			ctx.Abort()
+			return
+		}
+
+		remaining := opts.Limit - current
+		if remaining < 0 {
+			remaining = 0
+		}
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(remaining))
+
+		if current > opts.Limit {
+			ttl, _ := m.redis.PTTL(ctx, key).Result()
+			ctx.Header("Retry-After", strconv.Itoa(int(ttl/time.Second)))
+			resp.Error(ctx, errormessage.ErrRateLimitExceeded)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,33 @@
+// Package model contains Zenith's core persisted domain types, shared across
+// the account, auth, and admin packages.
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Account is a Zenith user, local or linked from a federated identity provider.
+type Account struct {
+	ID       uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Email    string    `gorm:"uniqueIndex;not null"`
+	Password string    `gorm:"not null"`
+	Name     string    `gorm:"not null"`
+	IsAdmin  bool      `gorm:"not null;default:false"`
+	IsActive bool      `gorm:"not null;default:true"`
+
+	// TokensValidAfter is a revocation watermark: access/refresh tokens
+	// issued before this time are rejected by the auth middleware, even
+	// though Zenith's PASETO tokens carry no server-side session state.
+	// Zero means no tokens have been bulk-revoked for this account.
+	TokensValidAfter time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName overrides the default pluralized table name.
+func (Account) TableName() string {
+	return "accounts"
+}
@@ -0,0 +1,42 @@
+// Package migration owns the set of GORM models Zenith auto-migrates at
+// startup, gathering each feature package's Models() into one AutoMigrate call.
+package migration
+
+import (
+	"log"
+
+	"github.com/arifai/zenith/internal/auth/oidc"
+	"github.com/arifai/zenith/internal/auth/social"
+	"github.com/arifai/zenith/internal/model"
+	"github.com/arifai/zenith/internal/notification"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Migration runs Zenith's AutoMigrate against db. id identifies the run in
+// logs when several instances migrate concurrently at deploy time.
+type Migration struct {
+	db *gorm.DB
+	id uuid.UUID
+}
+
+// New builds a Migration and immediately auto-migrates db.
+func New(db *gorm.DB, id uuid.UUID) *Migration {
+	m := &Migration{db: db, id: id}
+	m.AutoMigrate()
+
+	return m
+}
+
+// AutoMigrate creates or updates every table owned by Zenith's feature
+// packages: the core Account plus whatever each package's Models() contributes.
+func (m *Migration) AutoMigrate() {
+	models := []interface{}{&model.Account{}}
+	models = append(models, oidc.Models()...)
+	models = append(models, social.Models()...)
+	models = append(models, notification.Models()...)
+
+	if err := m.db.AutoMigrate(models...); err != nil {
+		log.Fatalf("migration %s: failed to auto-migrate: %v", m.id, err)
+	}
+}
@@ -0,0 +1,80 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sideshow/apns2"
+	"github.com/sideshow/apns2/payload"
+)
+
+// APNsNotifier is a Notifier backed by Apple Push Notification service.
+type APNsNotifier struct {
+	client *apns2.Client
+	topic  string
+}
+
+// NewAPNsNotifier builds an APNsNotifier from a configured apns2 client and
+// the app's bundle id (used as the APNs topic).
+func NewAPNsNotifier(client *apns2.Client, topic string) *APNsNotifier {
+	return &APNsNotifier{client: client, topic: topic}
+}
+
+// Send implements Notifier.
+func (n *APNsNotifier) Send(_ context.Context, notification Notification) error {
+	response, err := n.client.Push(n.toAPNsNotification(notification))
+	if err != nil {
+		return err
+	}
+
+	if !response.Sent() {
+		return &APNsError{Reason: response.Reason, ApnsID: response.ApnsID}
+	}
+
+	return nil
+}
+
+// APNsError wraps an APNs rejection reason so callers can inspect Reason
+// (e.g. to decide whether to prune the device token) without parsing a string.
+type APNsError struct {
+	Reason string
+	ApnsID string
+}
+
+// Error implements error.
+func (e *APNsError) Error() string {
+	return fmt.Sprintf("apns: push rejected: %s (%s)", e.Reason, e.ApnsID)
+}
+
+// SendMulticast implements Notifier. apns2 has no native batch API, so each
+// notification is pushed individually and its outcome collected.
+func (n *APNsNotifier) SendMulticast(ctx context.Context, notifications []Notification) ([]Result, error) {
+	results := make([]Result, len(notifications))
+	for i, notif := range notifications {
+		results[i] = Result{Token: notif.Token, Err: n.Send(ctx, notif)}
+	}
+
+	return results, nil
+}
+
+// SupportsTopics implements Notifier. apns2's Topic field is the app's
+// bundle id, not a subscriber fan-out mechanism, so APNs has no native way
+// to resolve Notification.Topic into devices.
+func (n *APNsNotifier) SupportsTopics() bool {
+	return false
+}
+
+// toAPNsNotification converts a Notification into an apns2 notification.
+func (n *APNsNotifier) toAPNsNotification(notification Notification) *apns2.Notification {
+	return &apns2.Notification{
+		DeviceToken: notification.Token,
+		Topic:       n.topic,
+		Payload:     payload.NewPayload().AlertTitle(notification.Title).AlertBody(notification.Body),
+	}
+}
+
+// isAPNsUnregistered reports whether reason is APNs' "the device token is no
+// longer active" reason, per https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns.
+func isAPNsUnregistered(reason string) bool {
+	return reason == apns2.ReasonUnregistered
+}
@@ -0,0 +1,27 @@
+package notification
+
+import (
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/pkg/firebase"
+	"github.com/sideshow/apns2"
+)
+
+const (
+	driverFCM  = "fcm"
+	driverAPNs = "apns"
+	driverLog  = "log"
+)
+
+// NewNotifier selects the Notifier implementation configured via
+// config.Config's NotificationDriver, defaulting to the log driver so local
+// development never needs real provider credentials.
+func NewNotifier(config *config.Config, messagingService *firebase.MessagingService, apnsClient *apns2.Client) Notifier {
+	switch config.NotificationDriver {
+	case driverFCM:
+		return NewFCMNotifier(messagingService)
+	case driverAPNs:
+		return NewAPNsNotifier(apnsClient, config.APNsTopic)
+	default:
+		return NewLogNotifier()
+	}
+}
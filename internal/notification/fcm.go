@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"context"
+
+	"firebase.google.com/go/v4/messaging"
+	"github.com/arifai/zenith/pkg/firebase"
+)
+
+// FCMNotifier is a Notifier backed by Firebase Cloud Messaging, wrapping the
+// existing firebase.MessagingService.
+type FCMNotifier struct {
+	messaging *firebase.MessagingService
+}
+
+// NewFCMNotifier builds an FCMNotifier around the given MessagingService.
+func NewFCMNotifier(messagingService *firebase.MessagingService) *FCMNotifier {
+	return &FCMNotifier{messaging: messagingService}
+}
+
+// Send implements Notifier.
+func (n *FCMNotifier) Send(ctx context.Context, notification Notification) error {
+	_, err := n.messaging.Client.Send(ctx, toFCMMessage(notification))
+	return err
+}
+
+// SendMulticast implements Notifier using FCM's batch send, so a failure for
+// one token doesn't abort delivery to the rest.
+func (n *FCMNotifier) SendMulticast(ctx context.Context, notifications []Notification) ([]Result, error) {
+	messages := make([]*messaging.Message, len(notifications))
+	for i, notif := range notifications {
+		messages[i] = toFCMMessage(notif)
+	}
+
+	batchResponse, err := n.messaging.Client.SendEach(ctx, messages)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(notifications))
+	for i, response := range batchResponse.Responses {
+		result := Result{Token: notifications[i].Token}
+		if !response.Success {
+			result.Err = response.Error
+		}
+		results[i] = result
+	}
+
+	return results, nil
+}
+
+// SupportsTopics implements Notifier. FCM resolves Message.Topic against
+// devices it subscribed via its own SDK-side mechanism.
+func (n *FCMNotifier) SupportsTopics() bool {
+	return true
+}
+
+// toFCMMessage converts a Notification into an FCM message.
+func toFCMMessage(notification Notification) *messaging.Message {
+	return &messaging.Message{
+		Token: notification.Token,
+		Topic: notification.Topic,
+		Data:  notification.Data,
+		Notification: &messaging.Notification{
+			Title: notification.Title,
+			Body:  notification.Body,
+		},
+	}
+}
+
+// isUnregistered reports whether err is FCM's "the token is no longer valid" error.
+func isUnregistered(err error) bool {
+	return messaging.IsRegistrationTokenNotRegistered(err)
+}
@@ -0,0 +1,114 @@
+package notification
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/arifai/zenith/pkg/common"
+	"github.com/arifai/zenith/pkg/core"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/arifai/zenith/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// registerDeviceTokenRequest is the body for RegisterDeviceTokenHandler.
+type registerDeviceTokenRequest struct {
+	Token    string   `json:"token" binding:"required"`
+	Platform Platform `json:"platform" binding:"required"`
+	Topics   []string `json:"topics"`
+}
+
+// RegisterDeviceTokenHandler upserts a device token for the authenticated
+// account and syncs its topic subscriptions in subs to match body.Topics.
+func RegisterDeviceTokenHandler(ctx *gin.Context, db *gorm.DB, subs *SubscriptionStore) {
+	resp := new(common.Response)
+	context := core.NewContext(ctx)
+	if context.Account == nil {
+		resp.Unauthorized(ctx, []utils.IError{}, errormessage.ErrCannotFindAuthorizedAccount.Error())
+		return
+	}
+
+	var body registerDeviceTokenRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	var existing DeviceToken
+	hadExisting := db.Where("token = ?", body.Token).First(&existing).Error == nil
+
+	deviceToken := DeviceToken{
+		AccountId: context.Account.ID,
+		Token:     body.Token,
+		Platform:  body.Platform,
+		Topics:    pq.StringArray(body.Topics),
+		LastSeen:  time.Now(),
+	}
+
+	err := db.Where("token = ?", body.Token).
+		Assign(deviceToken).
+		FirstOrCreate(&deviceToken).Error
+	if err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	var oldTopics []string
+	if hadExisting {
+		oldTopics = existing.Topics
+	}
+	syncTopicSubscriptions(ctx, subs, body.Token, oldTopics, body.Topics)
+
+	resp.Success(ctx, deviceToken)
+}
+
+// UnregisterDeviceTokenHandler removes a device token belonging to the
+// authenticated account, e.g. on logout, and drops its topic subscriptions.
+func UnregisterDeviceTokenHandler(ctx *gin.Context, db *gorm.DB, subs *SubscriptionStore) {
+	resp := new(common.Response)
+	context := core.NewContext(ctx)
+	if context.Account == nil {
+		resp.Unauthorized(ctx, []utils.IError{}, errormessage.ErrCannotFindAuthorizedAccount.Error())
+		return
+	}
+
+	token := ctx.Param("token")
+
+	var deviceToken DeviceToken
+	if err := db.Where("token = ? AND account_id = ?", token, context.Account.ID).First(&deviceToken).Error; err != nil {
+		resp.Error(ctx, errormessage.ErrDeviceTokenNotFound)
+		return
+	}
+
+	if err := db.Where("token = ? AND account_id = ?", token, context.Account.ID).Delete(&DeviceToken{}).Error; err != nil {
+		resp.Error(ctx, err)
+		return
+	}
+
+	syncTopicSubscriptions(ctx, subs, token, deviceToken.Topics, nil)
+
+	resp.Success(ctx, gin.H{"token": token})
+}
+
+// syncTopicSubscriptions reconciles token's Redis subscriber sets with its
+// newly-stored topic list, so Worker's topic fan-out always matches what a
+// client last registered.
+func syncTopicSubscriptions(ctx *gin.Context, subs *SubscriptionStore, token string, oldTopics, newTopics []string) {
+	newSet := make(map[string]bool, len(newTopics))
+	for _, topic := range newTopics {
+		newSet[topic] = true
+		if err := subs.Subscribe(ctx, topic, token); err != nil {
+			slog.Error("notification: failed to subscribe device token to topic", "topic", topic, "error", err)
+		}
+	}
+
+	for _, topic := range oldTopics {
+		if !newSet[topic] {
+			if err := subs.Unsubscribe(ctx, topic, token); err != nil {
+				slog.Error("notification: failed to unsubscribe device token from topic", "topic", topic, "error", err)
+			}
+		}
+	}
+}
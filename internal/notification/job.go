@@ -0,0 +1,50 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// jobStreamKey is the Redis stream notification jobs are published to and
+// consumed from.
+const jobStreamKey = "notification:jobs"
+
+// jobConsumerGroup is the Redis consumer group the Worker reads jobStreamKey with.
+const jobConsumerGroup = "notification-workers"
+
+// maxRetries bounds how many times a failing job is retried before it's dropped.
+const maxRetries = 5
+
+// job is a Notification queued for delivery, tracked through its retry attempts.
+type job struct {
+	Notification Notification `json:"notification"`
+	Attempt      int          `json:"attempt"`
+}
+
+// encode serializes a job for storage in the Redis stream.
+func (j job) encode() (string, error) {
+	payload, err := json.Marshal(j)
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
+
+// decodeJob deserializes a job previously written by encode.
+func decodeJob(payload string) (job, error) {
+	var j job
+	err := json.Unmarshal([]byte(payload), &j)
+	return j, err
+}
+
+// backoff returns the delay to wait before retrying attempt, using capped
+// exponential backoff.
+func backoff(attempt int) time.Duration {
+	delay := time.Second << attempt
+	if maxDelay := 2 * time.Minute; delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
@@ -0,0 +1,15 @@
+package notification
+
+import "expvar"
+
+// metrics tracks per-send counters, published through expvar alongside the
+// rest of the process's expvar metrics.
+var metrics = struct {
+	sent   *expvar.Int
+	failed *expvar.Int
+	pruned *expvar.Int
+}{
+	sent:   expvar.NewInt("notification_sent_total"),
+	failed: expvar.NewInt("notification_failed_total"),
+	pruned: expvar.NewInt("notification_pruned_total"),
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Platform identifies which push provider a DeviceToken belongs to.
+type Platform string
+
+const (
+	PlatformIOS     Platform = "ios"
+	PlatformAndroid Platform = "android"
+	PlatformWeb     Platform = "web"
+)
+
+// DeviceToken is a registered push target for an account.
+type DeviceToken struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	AccountId uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Token     string         `gorm:"uniqueIndex;not null"`
+	Platform  Platform       `gorm:"not null"`
+	Topics    pq.StringArray `gorm:"type:text[]"`
+	LastSeen  time.Time
+}
+
+// TableName overrides the default pluralized table name.
+func (DeviceToken) TableName() string {
+	return "device_tokens"
+}
+
+// Models returns the GORM models owned by the notification package, for
+// registration with internal/model/migration's AutoMigrate list (wired
+// through ProvideMigration).
+func Models() []interface{} {
+	return []interface{}{
+		&DeviceToken{},
+	}
+}
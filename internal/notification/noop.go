@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogNotifier is a Notifier that logs notifications instead of delivering
+// them, useful for local development and tests.
+type LogNotifier struct{}
+
+// NewLogNotifier builds a LogNotifier.
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// Send implements Notifier.
+func (n *LogNotifier) Send(_ context.Context, notification Notification) error {
+	slog.Info("notification suppressed by log driver", "account_id", notification.AccountId, "title", notification.Title)
+	return nil
+}
+
+// SendMulticast implements Notifier.
+func (n *LogNotifier) SendMulticast(ctx context.Context, notifications []Notification) ([]Result, error) {
+	results := make([]Result, len(notifications))
+	for i, notif := range notifications {
+		results[i] = Result{Token: notif.Token, Err: n.Send(ctx, notif)}
+	}
+
+	return results, nil
+}
+
+// SupportsTopics implements Notifier. The log driver never fans a topic out
+// since it has no real subscribers to log against.
+func (n *LogNotifier) SupportsTopics() bool {
+	return false
+}
@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Notification is a single push notification addressed to a device token or
+// a topic of subscribed devices.
+type Notification struct {
+	AccountId uuid.UUID
+	Token     string
+	Topic     string
+	Title     string
+	Body      string
+	Data      map[string]string
+}
+
+// Result is the per-notification outcome of a SendMulticast call.
+type Result struct {
+	Token string
+	Err   error
+}
+
+// Notifier abstracts over push notification providers (FCM, APNs, ...) so
+// callers don't need to know which platform a device token belongs to.
+type Notifier interface {
+	// Send delivers a single notification.
+	Send(ctx context.Context, notification Notification) error
+
+	// SendMulticast delivers several notifications, returning one Result per
+	// notification in the same order.
+	SendMulticast(ctx context.Context, notifications []Notification) ([]Result, error)
+
+	// SupportsTopics reports whether the provider itself fans a
+	// Notification.Topic out to its subscribed devices (as FCM does).
+	// Providers that answer false here never see Notification.Topic -
+	// Worker resolves it to individual tokens via SubscriptionStore first.
+	SupportsTopics() bool
+}
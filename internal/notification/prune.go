@@ -0,0 +1,40 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// Pruner removes device tokens that a provider has reported as no longer
+// registered, so future sends don't keep failing against them.
+type Pruner struct {
+	db *gorm.DB
+}
+
+// NewPruner builds a Pruner backed by db.
+func NewPruner(db *gorm.DB) *Pruner {
+	return &Pruner{db: db}
+}
+
+// ShouldPrune reports whether err indicates the target device token is no
+// longer valid and should be removed.
+func (p *Pruner) ShouldPrune(err error) bool {
+	if isUnregistered(err) {
+		return true
+	}
+
+	var apnsErr *APNsError
+	if errors.As(err, &apnsErr) {
+		return isAPNsUnregistered(apnsErr.Reason)
+	}
+
+	return false
+}
+
+// Prune deletes the device token row for token.
+func (p *Pruner) Prune(ctx context.Context, token string) error {
+	metrics.pruned.Add(1)
+	return p.db.WithContext(ctx).Where("token = ?", token).Delete(&DeviceToken{}).Error
+}
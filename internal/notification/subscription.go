@@ -0,0 +1,41 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// topicSubscribersPrefix namespaces the Redis set of device tokens subscribed to a topic.
+const topicSubscribersPrefix = "notification:topic:"
+
+// SubscriptionStore manages topic subscription lists in Redis.
+type SubscriptionStore struct {
+	redis *redis.Client
+}
+
+// NewSubscriptionStore builds a SubscriptionStore backed by the given Redis client.
+func NewSubscriptionStore(redis *redis.Client) *SubscriptionStore {
+	return &SubscriptionStore{redis: redis}
+}
+
+// Subscribe adds token to topic's subscriber set.
+func (s *SubscriptionStore) Subscribe(ctx context.Context, topic, token string) error {
+	return s.redis.SAdd(ctx, topicKey(topic), token).Err()
+}
+
+// Unsubscribe removes token from topic's subscriber set.
+func (s *SubscriptionStore) Unsubscribe(ctx context.Context, topic, token string) error {
+	return s.redis.SRem(ctx, topicKey(topic), token).Err()
+}
+
+// Subscribers returns every token subscribed to topic.
+func (s *SubscriptionStore) Subscribers(ctx context.Context, topic string) ([]string, error) {
+	return s.redis.SMembers(ctx, topicKey(topic)).Result()
+}
+
+// topicKey builds the Redis key for a topic's subscriber set.
+func topicKey(topic string) string {
+	return fmt.Sprintf("%s%s", topicSubscribersPrefix, topic)
+}
@@ -0,0 +1,175 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Worker reads notification jobs from a Redis stream and delivers them
+// through a Notifier, retrying failed deliveries with exponential backoff.
+type Worker struct {
+	redis    *redis.Client
+	notifier Notifier
+	pruner   *Pruner
+	subs     *SubscriptionStore
+	consumer string
+}
+
+// NewWorker builds a Worker. consumer identifies this worker instance within
+// the shared consumer group, e.g. a hostname or pod name.
+func NewWorker(redis *redis.Client, notifier Notifier, pruner *Pruner, subs *SubscriptionStore, consumer string) *Worker {
+	return &Worker{redis: redis, notifier: notifier, pruner: pruner, subs: subs, consumer: consumer}
+}
+
+// Enqueue publishes a notification job for asynchronous delivery.
+func (w *Worker) Enqueue(ctx context.Context, notification Notification) error {
+	payload, err := job{Notification: notification}.encode()
+	if err != nil {
+		return err
+	}
+
+	return w.redis.XAdd(ctx, &redis.XAddArgs{
+		Stream: jobStreamKey,
+		Values: map[string]interface{}{"job": payload},
+	}).Err()
+}
+
+// Run consumes jobs until ctx is cancelled, blocking between reads.
+func (w *Worker) Run(ctx context.Context) error {
+	if err := w.redis.XGroupCreateMkStream(ctx, jobStreamKey, jobConsumerGroup, "0").Err(); err != nil && !isBusyGroupErr(err) {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		streams, err := w.redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    jobConsumerGroup,
+			Consumer: w.consumer,
+			Streams:  []string{jobStreamKey, ">"},
+			Count:    10,
+			Block:    5 * time.Second,
+		}).Result()
+		if err != nil {
+			if err == redis.Nil {
+				continue
+			}
+			slog.Error("notification worker: failed to read jobs", "error", err)
+			continue
+		}
+
+		for _, stream := range streams {
+			for _, message := range stream.Messages {
+				w.handle(ctx, message)
+			}
+		}
+	}
+}
+
+// handle delivers a single stream message and then acknowledges it, whether
+// or not the send succeeded. A failed send is retried by retryOrDrop, which
+// publishes the job again as a new stream message after a backoff delay.
+func (w *Worker) handle(ctx context.Context, message redis.XMessage) {
+	payload, _ := message.Values["job"].(string)
+	j, err := decodeJob(payload)
+	if err != nil {
+		slog.Error("notification worker: failed to decode job", "error", err)
+		w.redis.XAck(ctx, jobStreamKey, jobConsumerGroup, message.ID)
+		return
+	}
+
+	if j.Notification.Topic != "" && !w.notifier.SupportsTopics() {
+		w.sendToTopicSubscribers(ctx, j)
+	} else if err := w.notifier.Send(ctx, j.Notification); err != nil {
+		metrics.failed.Add(1)
+		w.retryOrDrop(ctx, j, err)
+	} else {
+		metrics.sent.Add(1)
+	}
+
+	w.redis.XAck(ctx, jobStreamKey, jobConsumerGroup, message.ID)
+}
+
+// sendToTopicSubscribers expands j's topic into one send per subscribed
+// device token, for providers (e.g. APNs) that have no native way to
+// resolve a topic to its subscribers themselves. Unlike handle's single-
+// notification path, a failed send here is pruned if the provider reports
+// the token dead, but isn't individually retried - re-running the whole
+// topic fan-out would redeliver to every subscriber that already succeeded.
+func (w *Worker) sendToTopicSubscribers(ctx context.Context, j job) {
+	tokens, err := w.subs.Subscribers(ctx, j.Notification.Topic)
+	if err != nil {
+		slog.Error("notification worker: failed to resolve topic subscribers", "topic", j.Notification.Topic, "error", err)
+		return
+	}
+
+	notifications := make([]Notification, len(tokens))
+	for i, token := range tokens {
+		notifications[i] = j.Notification
+		notifications[i].Token = token
+		notifications[i].Topic = ""
+	}
+
+	results, err := w.notifier.SendMulticast(ctx, notifications)
+	if err != nil {
+		slog.Error("notification worker: topic multicast failed", "topic", j.Notification.Topic, "error", err)
+		return
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			metrics.sent.Add(1)
+			continue
+		}
+
+		metrics.failed.Add(1)
+		if w.pruner != nil && w.pruner.ShouldPrune(result.Err) {
+			if err := w.pruner.Prune(ctx, result.Token); err != nil {
+				slog.Error("notification worker: failed to prune token", "error", err)
+			}
+		}
+	}
+}
+
+// retryOrDrop re-enqueues j after a backoff delay, pruning the device token
+// if the provider reported it as no longer registered, and giving up once
+// maxRetries is exceeded.
+func (w *Worker) retryOrDrop(ctx context.Context, j job, sendErr error) {
+	if w.pruner != nil && w.pruner.ShouldPrune(sendErr) {
+		if err := w.pruner.Prune(ctx, j.Notification.Token); err != nil {
+			slog.Error("notification worker: failed to prune token", "error", err)
+		}
+		return
+	}
+
+	if j.Attempt >= maxRetries {
+		slog.Error("notification worker: dropping job after exhausting retries", "error", sendErr)
+		return
+	}
+
+	time.AfterFunc(backoff(j.Attempt), func() {
+		j.Attempt++
+		payload, err := j.encode()
+		if err != nil {
+			return
+		}
+
+		w.redis.XAdd(context.Background(), &redis.XAddArgs{
+			Stream: jobStreamKey,
+			Values: map[string]interface{}{"job": payload},
+		})
+	})
+}
+
+// isBusyGroupErr reports whether err is Redis' "consumer group already
+// exists" error, which is expected on every worker restart.
+func isBusyGroupErr(err error) bool {
+	return err != nil && len(err.Error()) >= len("BUSYGROUP") && err.Error()[:len("BUSYGROUP")] == "BUSYGROUP"
+}
@@ -0,0 +1,61 @@
+// Package common holds the response envelope Zenith's HTTP handlers use to
+// reply consistently across packages.
+package common
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/gin-gonic/gin"
+)
+
+// envelope is the JSON shape every Response method replies with.
+type envelope struct {
+	Success bool        `json:"success"`
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+	Errors  interface{} `json:"errors,omitempty"`
+}
+
+// Response writes JSON responses for Zenith's HTTP handlers.
+type Response struct{}
+
+// Success replies 200 with data.
+func (r *Response) Success(ctx *gin.Context, data interface{}) {
+	ctx.JSON(http.StatusOK, envelope{Success: true, Data: data})
+}
+
+// Authorized replies 200 with an authentication result, e.g. a token pair.
+func (r *Response) Authorized(ctx *gin.Context, data interface{}) {
+	ctx.JSON(http.StatusOK, envelope{Success: true, Data: data})
+}
+
+// Unauthorized replies 401 with message and any field-level errs.
+func (r *Response) Unauthorized(ctx *gin.Context, errs interface{}, message string) {
+	ctx.JSON(http.StatusUnauthorized, envelope{Success: false, Message: message, Errors: errs})
+}
+
+// Error replies with err's message, at a status code chosen by its sentinel.
+func (r *Response) Error(ctx *gin.Context, err error) {
+	ctx.JSON(statusFor(err), envelope{Success: false, Message: err.Error()})
+}
+
+// statusFor maps known sentinel errors to their HTTP status; anything else
+// is treated as a bad request.
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, errormessage.ErrRateLimitExceeded):
+		return http.StatusTooManyRequests
+	case errors.Is(err, errormessage.ErrInvalidAccessToken),
+		errors.Is(err, errormessage.ErrInvalidTokenType),
+		errors.Is(err, errormessage.ErrMissingAuthorizationHeader),
+		errors.Is(err, errormessage.ErrInvalidCredentials):
+		return http.StatusUnauthorized
+	case errors.Is(err, errormessage.ErrCannotFindAuthorizedAccount),
+		errors.Is(err, errormessage.ErrDeviceTokenNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -0,0 +1,29 @@
+// Package core wraps the per-request state handlers need beyond what
+// *gin.Context exposes directly.
+package core
+
+import (
+	"github.com/arifai/zenith/internal/model"
+	"github.com/gin-gonic/gin"
+)
+
+// Context carries the request's gin.Context plus the account Auth
+// authenticated it as, if any.
+type Context struct {
+	Ctx     *gin.Context
+	Account *model.Account
+}
+
+// NewContext builds a Context from ctx, pulling in the *model.Account that
+// the Auth middleware set, if the route requires authentication.
+func NewContext(ctx *gin.Context) *Context {
+	context := &Context{Ctx: ctx}
+
+	if account, ok := ctx.Get("account"); ok {
+		if a, ok := account.(*model.Account); ok {
+			context.Account = a
+		}
+	}
+
+	return context
+}
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptHash is a PasswordHasher backed by bcrypt, kept for verifying hashes
+// created before Zenith standardized on Argon2ID.
+type BcryptHash struct {
+	Cost int
+}
+
+// DefaultBcryptHash is a BcryptHash configured with bcrypt's recommended default cost.
+var DefaultBcryptHash = &BcryptHash{Cost: bcrypt.DefaultCost}
+
+// Hash implements PasswordHasher.
+func (b *BcryptHash) Hash(password []byte) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword(password, b.Cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+// Verify implements PasswordHasher. needsRehash is true when encoded was
+// hashed with a lower cost than b is currently configured for.
+func (b *BcryptHash) Verify(password []byte, encoded string) (bool, bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), password)
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, false, nil
+	} else if err != nil {
+		return false, false, err
+	}
+
+	cost, err := bcrypt.Cost([]byte(encoded))
+	if err != nil {
+		return true, false, err
+	}
+
+	return true, cost < b.Cost, nil
+}
+
+// ID implements PasswordHasher.
+func (b *BcryptHash) ID() string {
+	return "bcrypt"
+}
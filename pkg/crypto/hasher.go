@@ -0,0 +1,18 @@
+package crypto
+
+// PasswordHasher abstracts over password hashing algorithms so the service
+// layer can verify a password without caring which algorithm produced its
+// hash, and can tell when a verified hash should be upgraded.
+type PasswordHasher interface {
+	// Hash hashes password and returns the encoded hash to persist.
+	Hash(password []byte) (string, error)
+
+	// Verify checks password against encoded. needsRehash is true when the
+	// password matched but encoded was produced by a weaker algorithm or
+	// weaker parameters than this hasher is currently configured for.
+	Verify(password []byte, encoded string) (ok bool, needsRehash bool, err error)
+
+	// ID identifies the algorithm; MultiHasher uses it to match an encoded
+	// hash's prefix to the hasher that produced it.
+	ID() string
+}
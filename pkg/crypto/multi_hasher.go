@@ -0,0 +1,74 @@
+package crypto
+
+import (
+	"strings"
+
+	"github.com/arifai/zenith/pkg/errormessage"
+)
+
+// MultiHasher is a PasswordHasher that dispatches Verify to whichever
+// algorithm produced the encoded hash, so historical hashes keep verifying
+// while Hash always uses Default to produce new ones.
+type MultiHasher struct {
+	Default PasswordHasher
+	others  []PasswordHasher
+}
+
+// NewMultiHasher builds a MultiHasher that hashes new passwords with
+// defaultHasher and can verify hashes produced by any of others as well.
+func NewMultiHasher(defaultHasher PasswordHasher, others ...PasswordHasher) *MultiHasher {
+	return &MultiHasher{Default: defaultHasher, others: others}
+}
+
+// Hash implements PasswordHasher by always using the default algorithm.
+func (m *MultiHasher) Hash(password []byte) (string, error) {
+	return m.Default.Hash(password)
+}
+
+// Verify implements PasswordHasher. It selects the hasher matching encoded's
+// prefix and delegates to it; needsRehash is also true whenever encoded was
+// not produced by the default hasher, since it should be upgraded on next login.
+func (m *MultiHasher) Verify(password []byte, encoded string) (bool, bool, error) {
+	hasher, err := m.selectHasher(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	ok, needsRehash, err := hasher.Verify(password, encoded)
+	if err != nil || !ok {
+		return ok, false, err
+	}
+
+	return true, needsRehash || hasher.ID() != m.Default.ID(), nil
+}
+
+// ID implements PasswordHasher, reporting the default algorithm.
+func (m *MultiHasher) ID() string {
+	return m.Default.ID()
+}
+
+// selectHasher picks the PasswordHasher matching encoded's `$algo$` prefix.
+func (m *MultiHasher) selectHasher(encoded string) (PasswordHasher, error) {
+	for _, hasher := range append([]PasswordHasher{m.Default}, m.others...) {
+		if hasherMatchesPrefix(hasher, encoded) {
+			return hasher, nil
+		}
+	}
+
+	return nil, errormessage.ErrInvalidEncodedHash
+}
+
+// hasherMatchesPrefix reports whether encoded was produced by hasher, based
+// on the encoding prefix each algorithm in this package uses.
+func hasherMatchesPrefix(hasher PasswordHasher, encoded string) bool {
+	switch hasher.ID() {
+	case "argon2id":
+		return strings.HasPrefix(encoded, "$argon2id$")
+	case "bcrypt":
+		return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+	case "scrypt":
+		return strings.HasPrefix(encoded, "$scrypt$")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+package crypto
+
+import "testing"
+
+func TestMultiHasherVerifyDispatchesByPrefix(t *testing.T) {
+	multi := NewMultiHasher(DefaultArgon2IDHash, DefaultBcryptHash, DefaultScryptHash)
+	password := []byte("correct horse battery staple")
+
+	bcryptHash, err := DefaultBcryptHash.Hash(password)
+	if err != nil {
+		t.Fatalf("failed to hash with bcrypt: %v", err)
+	}
+
+	ok, needsRehash, err := multi.Verify(password, bcryptHash)
+	if err != nil {
+		t.Fatalf("unexpected error verifying bcrypt hash: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a bcrypt hash to verify against the original password")
+	}
+	if !needsRehash {
+		t.Fatal("expected a bcrypt hash to be flagged for rehash to the default algorithm")
+	}
+}
+
+func TestMultiHasherHashUsesDefault(t *testing.T) {
+	multi := NewMultiHasher(DefaultArgon2IDHash, DefaultBcryptHash)
+	password := []byte("correct horse battery staple")
+
+	encoded, err := multi.Hash(password)
+	if err != nil {
+		t.Fatalf("unexpected error hashing password: %v", err)
+	}
+
+	ok, needsRehash, err := multi.Verify(password, encoded)
+	if err != nil {
+		t.Fatalf("unexpected error verifying freshly hashed password: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected a freshly hashed password to verify")
+	}
+	if needsRehash {
+		t.Fatal("expected a hash produced by the default algorithm not to need rehashing")
+	}
+}
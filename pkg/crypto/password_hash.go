@@ -24,6 +24,12 @@ type Argon2IdHash struct {
 // memory, threads, key length, and salt length. Reference: https://cheatsheetseries.owasp.org/cheatsheets/Password_Storage_Cheat_Sheet.html#argon2id
 var DefaultArgon2IDHash = &Argon2IdHash{Time: 2, Memory: 19 * 1024, Threads: 1, KeyLen: 32, SaltLen: 32}
 
+// NewArgon2IdHash builds an Argon2IdHash from operator-tunable cost
+// parameters, e.g. those loaded onto config.Config.
+func NewArgon2IdHash(time, memory uint32, threads uint8, keyLen, saltLen uint32) *Argon2IdHash {
+	return &Argon2IdHash{Time: time, Memory: memory, Threads: threads, KeyLen: keyLen, SaltLen: saltLen}
+}
+
 // Argon2Version is the version of the argon2 algorithm
 const Argon2Version = argon2.Version
 
@@ -60,6 +66,37 @@ func encodeHashComponents(salt, hash []byte, a *Argon2IdHash) string {
 	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", Argon2Version, a.Memory, a.Time, a.Threads, base64Salt, base64Hash)
 }
 
+// Hash implements PasswordHasher by generating a fresh-salt Argon2ID hash.
+func (a *Argon2IdHash) Hash(password []byte) (string, error) {
+	return a.GenerateHash(password, nil)
+}
+
+// Verify implements PasswordHasher. needsRehash is true when encoded was
+// produced with cost parameters weaker than a's current configuration.
+func (a *Argon2IdHash) Verify(password []byte, encoded string) (bool, bool, error) {
+	ok, err := VerifyHash(string(password), encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	return ok, ok && a.needsRehash(encoded), nil
+}
+
+// ID implements PasswordHasher.
+func (a *Argon2IdHash) ID() string {
+	return "argon2id"
+}
+
+// needsRehash reports whether encoded was hashed with weaker parameters than a.
+func (a *Argon2IdHash) needsRehash(encoded string) bool {
+	other, _, _, err := decodeHash(encoded)
+	if err != nil {
+		return false
+	}
+
+	return other.Time < a.Time || other.Memory < a.Memory || other.Threads < a.Threads || other.KeyLen < a.KeyLen
+}
+
 // VerifyHash compares a password with its encoded hash to check for validity.
 // It returns true if the password matches the hash, false otherwise. If an error occurs during the process,
 // it returns false and the error.
@@ -0,0 +1,92 @@
+package crypto
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/arifai/zenith/pkg/errormessage"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ScryptHash is a PasswordHasher backed by scrypt, kept for verifying hashes
+// created before Zenith standardized on Argon2ID.
+type ScryptHash struct {
+	N       int
+	R       int
+	P       int
+	KeyLen  int
+	SaltLen int
+}
+
+// DefaultScryptHash is a ScryptHash configured with scrypt's commonly
+// recommended interactive-login parameters.
+var DefaultScryptHash = &ScryptHash{N: 32768, R: 8, P: 1, KeyLen: 32, SaltLen: 16}
+
+// Hash implements PasswordHasher.
+func (s *ScryptHash) Hash(password []byte) (string, error) {
+	salt, err := generateBytes(uint32(s.SaltLen))
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := scrypt.Key(password, salt, s.N, s.R, s.P, s.KeyLen)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("$scrypt$n=%d,r=%d,p=%d$%s$%s", s.N, s.R, s.P,
+		base64.StdEncoding.EncodeToString(salt), base64.StdEncoding.EncodeToString(hash)), nil
+}
+
+// Verify implements PasswordHasher. needsRehash is true when encoded was
+// hashed with weaker parameters than s is currently configured for.
+func (s *ScryptHash) Verify(password []byte, encoded string) (bool, bool, error) {
+	params, salt, hash, err := decodeScryptHash(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	otherHash, err := scrypt.Key(password, salt, params.N, params.R, params.P, len(hash))
+	if err != nil {
+		return false, false, err
+	}
+
+	if subtle.ConstantTimeCompare(hash, otherHash) != 1 {
+		return false, false, nil
+	}
+
+	needsRehash := params.N < s.N || params.R < s.R || params.P < s.P || len(hash) < s.KeyLen
+	return true, needsRehash, nil
+}
+
+// ID implements PasswordHasher.
+func (s *ScryptHash) ID() string {
+	return "scrypt"
+}
+
+// decodeScryptHash decodes an encoded scrypt hash into its cost parameters, salt, and hash.
+func decodeScryptHash(encoded string) (params *ScryptHash, salt, hash []byte, err error) {
+	value := strings.Split(encoded, "$")
+	if len(value) != 5 {
+		return nil, nil, nil, errormessage.ErrInvalidEncodedHash
+	}
+
+	params = &ScryptHash{}
+	if _, err = fmt.Sscanf(value[2], "n=%d,r=%d,p=%d", &params.N, &params.R, &params.P); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if salt, err = base64.StdEncoding.DecodeString(value[3]); err != nil {
+		return nil, nil, nil, err
+	}
+	params.SaltLen = len(salt)
+
+	if hash, err = base64.StdEncoding.DecodeString(value[4]); err != nil {
+		return nil, nil, nil, err
+	}
+	params.KeyLen = len(hash)
+
+	return params, salt, hash, nil
+}
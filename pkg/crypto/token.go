@@ -0,0 +1,85 @@
+package crypto
+
+import (
+	"time"
+
+	"aidanwoods.dev/go-paseto"
+	"github.com/arifai/zenith/config"
+	"github.com/arifai/zenith/pkg/errormessage"
+	"github.com/google/uuid"
+)
+
+// TokenPayload is the set of claims Zenith's PASETO access/refresh tokens carry.
+type TokenPayload struct {
+	TokenType string
+	Jti       uuid.UUID
+	AccountId uuid.UUID
+	IssuedAt  time.Time
+	Exp       time.Time
+}
+
+// GenerateToken mints a PASETO v4.public token of tokenType for accountId,
+// valid for ttl.
+func GenerateToken(accountId uuid.UUID, tokenType string, ttl time.Duration) (string, error) {
+	now := time.Now()
+
+	token := paseto.NewToken()
+	token.SetIssuedAt(now)
+	token.SetExpiration(now.Add(ttl))
+	token.SetString("account_id", accountId.String())
+	token.SetString("token_type", tokenType)
+	token.SetString("jti", uuid.NewString())
+
+	return token.V4Sign(config.SecretKey, nil), nil
+}
+
+// VerifyToken parses and validates a PASETO v4.public token, returning its claims.
+func VerifyToken(tokenString string, publicKey paseto.V4AsymmetricPublicKey) (*TokenPayload, error) {
+	token, err := paseto.NewParser().ParseV4Public(publicKey, tokenString, nil)
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	accountIdStr, err := token.GetString("account_id")
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	accountId, err := uuid.Parse(accountIdStr)
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	tokenType, err := token.GetString("token_type")
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	jtiStr, err := token.GetString("jti")
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	jti, err := uuid.Parse(jtiStr)
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	issuedAt, err := token.GetIssuedAt()
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	exp, err := token.GetExpiration()
+	if err != nil {
+		return nil, errormessage.ErrInvalidAccessToken
+	}
+
+	return &TokenPayload{
+		TokenType: tokenType,
+		Jti:       jti,
+		AccountId: accountId,
+		IssuedAt:  issuedAt,
+		Exp:       exp,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Package errormessage centralizes the sentinel errors Zenith's API and
+// supporting packages return, so handlers can map them to responses without
+// each package inventing its own wording.
+package errormessage
+
+import "errors"
+
+var (
+	// ErrInvalidSaltLength is returned when a salt passed into a hasher
+	// doesn't match its configured length.
+	ErrInvalidSaltLength = errors.New("errormessage: invalid salt length")
+
+	// ErrInvalidEncodedHash is returned when an encoded password hash can't
+	// be parsed, or matches no known hasher.
+	ErrInvalidEncodedHash = errors.New("errormessage: invalid encoded hash")
+
+	// ErrIncompatibleArgon2Version is returned when an encoded Argon2 hash
+	// was produced by an incompatible algorithm version.
+	ErrIncompatibleArgon2Version = errors.New("errormessage: incompatible argon2 version")
+
+	// ErrInvalidCredentials is returned when an email/password pair doesn't
+	// match an active account.
+	ErrInvalidCredentials = errors.New("errormessage: invalid email or password")
+
+	// ErrMissingAuthorizationHeader is returned when a request to an
+	// authenticated route carries no Authorization header.
+	ErrMissingAuthorizationHeader = errors.New("errormessage: missing authorization header")
+
+	// ErrInvalidAccessToken is returned when a bearer token is malformed,
+	// expired, blacklisted, or fails signature verification.
+	ErrInvalidAccessToken = errors.New("errormessage: invalid access token")
+
+	// ErrInvalidTokenType is returned when a token valid for one purpose
+	// (e.g. a refresh token) is presented where an access token is required.
+	ErrInvalidTokenType = errors.New("errormessage: invalid token type")
+
+	// ErrCannotFindAuthorizedAccount is returned when a valid token's
+	// account can no longer be found or has been deactivated.
+	ErrCannotFindAuthorizedAccount = errors.New("errormessage: cannot find authorized account")
+
+	// ErrRateLimitExceeded is returned when a caller exceeds a configured
+	// rate limit.
+	ErrRateLimitExceeded = errors.New("errormessage: rate limit exceeded")
+
+	// ErrDeviceTokenNotFound is returned when a device token doesn't exist,
+	// or doesn't belong to the caller.
+	ErrDeviceTokenNotFound = errors.New("errormessage: device token not found")
+)
@@ -2,8 +2,14 @@ package firebase
 
 import (
 	"context"
+	"expvar"
 	"firebase.google.com/go/v4/messaging"
-	"log"
+	"log/slog"
+)
+
+var (
+	sentCounter   = expvar.NewInt("firebase_messaging_sent_total")
+	failedCounter = expvar.NewInt("firebase_messaging_failed_total")
 )
 
 type MessagingService struct{ *Messaging }
@@ -24,10 +30,13 @@ func (m *MessagingService) SendMessage(data map[string]string, token, title, bod
 
 	response, err := m.Client.Send(context.Background(), message)
 	if err != nil {
+		failedCounter.Add(1)
+		slog.Error("failed to send push notification", "token", token, "error", err)
 		return err
 	}
 
-	log.Printf("successful send push notification: %v", response)
+	sentCounter.Add(1)
+	slog.Info("sent push notification", "token", token, "message_id", response)
 
 	return nil
 }
@@ -0,0 +1,22 @@
+// Package utils holds small request-handling helpers shared across Zenith's
+// HTTP handlers.
+package utils
+
+import "github.com/gin-gonic/gin"
+
+// IError describes a single field-level validation failure.
+type IError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidateBody binds and validates ctx's JSON body into T, relying on gin's
+// validator to enforce the struct's `binding` tags.
+func ValidateBody[T any](ctx *gin.Context) (*T, error) {
+	var body T
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		return nil, err
+	}
+
+	return &body, nil
+}